@@ -0,0 +1,11 @@
+module github.com/konstructio/cli-utils
+
+go 1.23.0
+
+require (
+	github.com/mattn/go-runewidth v0.0.16
+	golang.org/x/sys v0.31.0
+	golang.org/x/term v0.30.0
+)
+
+require github.com/rivo/uniseg v0.2.0 // indirect
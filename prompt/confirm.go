@@ -0,0 +1,91 @@
+package prompt
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Default is the answer Confirm picks when the user submits an empty line.
+type Default int
+
+const (
+	// DefaultNone requires an explicit answer.
+	DefaultNone Default = iota
+	// DefaultYes treats an empty answer as yes.
+	DefaultYes
+	// DefaultNo treats an empty answer as no.
+	DefaultNo
+)
+
+// Confirm asks a yes/no question and reports whether the user agreed.
+// Answers are case-insensitive "y", "yes", "true", "n", "no" or "false";
+// anything else asks again, up to the attempts set with WithMaxAttempts.
+//
+// When r is not a terminal or WithNoInput is set, the answer comes from the
+// sources set with WithFallback, in the same forms. If none has one,
+// Confirm returns a *NoInputError, or the default answer if
+// WithNonInteractive(DefaultNonInteractive) is set.
+func Confirm(w io.Writer, r io.Reader, label string, def Default, opts ...Option) (bool, error) {
+	o := newOptions(opts)
+
+	if len(o.validators) > 0 {
+		return false, fmt.Errorf("unable to confirm %q: validators are not supported", label)
+	}
+
+	if !o.interactive(r) {
+		if value, ok := o.lookup(); ok {
+			yes, valid := parseYesNo(value)
+			if !valid {
+				return false, fmt.Errorf("invalid answer for %q: %q is not yes or no", label, value)
+			}
+			return yes, nil
+		}
+		if o.nonInteractive == DefaultNonInteractive && def != DefaultNone {
+			return def == DefaultYes, nil
+		}
+		return false, o.noInputError(label)
+	}
+
+	hint := "[y/n]"
+	switch def {
+	case DefaultYes:
+		hint = "[Y/n]"
+	case DefaultNo:
+		hint = "[y/N]"
+	}
+
+	for attempt := 1; ; attempt++ {
+		if _, err := fmt.Fprintf(w, "%s %s: ", label, hint); err != nil {
+			return false, fmt.Errorf("unable to write prompt: %w", err)
+		}
+
+		answer, err := readLine(r)
+		if err != nil {
+			return false, err
+		}
+
+		if strings.TrimSpace(answer) == "" && def != DefaultNone {
+			return def == DefaultYes, nil
+		}
+		if yes, valid := parseYesNo(answer); valid {
+			return yes, nil
+		}
+
+		fmt.Fprintln(w, errorText(w, "please answer yes or no"))
+		if o.tooManyAttempts(attempt) {
+			return false, fmt.Errorf("unable to confirm %q: %w", label, ErrTooManyAttempts)
+		}
+	}
+}
+
+// parseYesNo parses a yes/no answer and reports whether it was valid.
+func parseYesNo(answer string) (yes, valid bool) {
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes", "true":
+		return true, true
+	case "n", "no", "false":
+		return false, true
+	}
+	return false, false
+}
@@ -0,0 +1,135 @@
+package prompt
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestConfirm(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		def     Default
+		want    bool
+		wantOut string
+	}{
+		{name: "y", in: "y\n", want: true, wantOut: "Delete? [y/n]: "},
+		{name: "yes uppercase", in: "YES\n", want: true, wantOut: "Delete? [y/n]: "},
+		{name: "n", in: "n\n", want: false, wantOut: "Delete? [y/n]: "},
+		{name: "no with spaces", in: "  no \r\n", want: false, wantOut: "Delete? [y/n]: "},
+		{name: "true", in: "true\n", want: true, wantOut: "Delete? [y/n]: "},
+		{name: "false", in: "False\n", want: false, wantOut: "Delete? [y/n]: "},
+		{name: "default yes", in: "\n", def: DefaultYes, want: true, wantOut: "Delete? [Y/n]: "},
+		{name: "default no", in: "\n", def: DefaultNo, want: false, wantOut: "Delete? [y/N]: "},
+		{name: "answer overrides default", in: "y\n", def: DefaultNo, want: true, wantOut: "Delete? [y/N]: "},
+		{
+			name:    "empty answer without default",
+			in:      "\nn\n",
+			want:    false,
+			wantOut: "Delete? [y/n]:   ✗ please answer yes or no\nDelete? [y/n]: ",
+		},
+		{
+			name:    "invalid answer",
+			in:      "maybe\ny\n",
+			def:     DefaultNo,
+			want:    true,
+			wantOut: "Delete? [y/N]:   ✗ please answer yes or no\nDelete? [y/N]: ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out strings.Builder
+			got, err := Confirm(&out, strings.NewReader(tt.in), "Delete?", tt.def)
+			if err != nil {
+				t.Fatalf("Confirm() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Confirm() = %v, want %v", got, tt.want)
+			}
+			if out.String() != tt.wantOut {
+				t.Errorf("output = %q, want %q", out.String(), tt.wantOut)
+			}
+		})
+	}
+}
+
+func TestConfirmNonInteractive(t *testing.T) {
+	tests := []struct {
+		name    string
+		def     Default
+		opts    []Option
+		want    bool
+		wantErr bool
+	}{
+		{name: "fails by default", def: DefaultYes, wantErr: true},
+		{name: "default yes", def: DefaultYes, opts: []Option{WithNonInteractive(DefaultNonInteractive)}, want: true},
+		{name: "default no", def: DefaultNo, opts: []Option{WithNonInteractive(DefaultNonInteractive)}, want: false},
+		{name: "no default", def: DefaultNone, opts: []Option{WithNonInteractive(DefaultNonInteractive)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Confirm(&strings.Builder{}, pipe(t, "y\n"), "Delete?", tt.def, tt.opts...)
+			if tt.wantErr {
+				if !errors.Is(err, ErrNonInteractive) {
+					t.Errorf("Confirm() error = %v, want ErrNonInteractive", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Confirm() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Confirm() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseYesNo(t *testing.T) {
+	tests := []struct {
+		in        string
+		wantYes   bool
+		wantValid bool
+	}{
+		{in: "y", wantYes: true, wantValid: true},
+		{in: "Yes", wantYes: true, wantValid: true},
+		{in: "true", wantYes: true, wantValid: true},
+		{in: "N", wantValid: true},
+		{in: "no", wantValid: true},
+		{in: "false", wantValid: true},
+		{in: ""},
+		{in: "1"},
+		{in: "yep"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			yes, valid := parseYesNo(tt.in)
+			if yes != tt.wantYes || valid != tt.wantValid {
+				t.Errorf("parseYesNo(%q) = %v, %v, want %v, %v", tt.in, yes, valid, tt.wantYes, tt.wantValid)
+			}
+		})
+	}
+}
+
+// pipe returns a file that is not a terminal, holding in, to exercise the
+// non-interactive paths.
+func pipe(t *testing.T, in string) *os.File {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe() error = %v", err)
+	}
+	t.Cleanup(func() { r.Close() })
+
+	if _, err := w.WriteString(in); err != nil {
+		t.Fatalf("WriteString() error = %v", err)
+	}
+	w.Close()
+	return r
+}
@@ -0,0 +1,113 @@
+package prompt
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Source supplies an answer without asking the user. Sources are consulted
+// in order when a prompt can't be shown, see WithFallback.
+type Source interface {
+	// Lookup returns the answer and whether the source has one.
+	Lookup() (string, bool)
+	// String describes the source in errors, e.g. "flag --cluster-name".
+	String() string
+}
+
+type source struct {
+	desc   string
+	lookup func() (string, bool)
+}
+
+func (s source) Lookup() (string, bool) { return s.lookup() }
+func (s source) String() string         { return s.desc }
+
+// FromFlag answers with the value of the command line flag name. An empty
+// value counts as unset.
+func FromFlag(name, value string) Source {
+	return source{
+		desc:   "flag --" + name,
+		lookup: func() (string, bool) { return value, value != "" },
+	}
+}
+
+// FromEnv answers with the environment variable key, if it is set and not
+// empty.
+func FromEnv(key string) Source {
+	return source{
+		desc: "env " + key,
+		lookup: func() (string, bool) {
+			value := os.Getenv(key)
+			return value, value != ""
+		},
+	}
+}
+
+// FromFunc answers with the result of fn, for sources such as a config
+// file. desc describes the source in errors, e.g. "config key cluster.name".
+func FromFunc(desc string, fn func() (string, bool)) Source {
+	return source{desc: desc, lookup: fn}
+}
+
+// NoInputError is returned when a prompt can't be shown and none of its
+// fallback sources has an answer. It matches ErrNonInteractive with
+// errors.Is.
+type NoInputError struct {
+	Label   string
+	Sources []Source
+}
+
+func (e *NoInputError) Error() string {
+	if len(e.Sources) == 0 {
+		return fmt.Sprintf("no answer for %q: %s", e.Label, ErrNonInteractive)
+	}
+
+	tried := make([]string, 0, len(e.Sources))
+	for _, s := range e.Sources {
+		tried = append(tried, s.String())
+	}
+	return fmt.Sprintf("no answer for %q: %s and none of %s is set", e.Label, ErrNonInteractive, strings.Join(tried, ", "))
+}
+
+func (e *NoInputError) Unwrap() error {
+	return ErrNonInteractive
+}
+
+// lookup returns the first answer provided by the fallback sources.
+func (o *options) lookup() (string, bool) {
+	for _, s := range o.fallbacks {
+		if value, ok := s.Lookup(); ok {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// interactive reports whether the prompt may ask the user.
+func (o *options) interactive(r io.Reader) bool {
+	return !o.noInput && isInteractive(r)
+}
+
+func (o *options) noInputError(label string) error {
+	return &NoInputError{Label: label, Sources: o.fallbacks}
+}
+
+// answer resolves a text prompt that can't ask, from its fallbacks or, with
+// DefaultNonInteractive, its default. The answer is validated like a typed
+// one.
+func (o *options) answer(label string) (string, error) {
+	value, ok := o.lookup()
+	if !ok && o.nonInteractive == DefaultNonInteractive && o.defaultValue != "" {
+		value, ok = o.defaultValue, true
+	}
+	if !ok {
+		return "", o.noInputError(label)
+	}
+
+	if err := validate(o.validators, value); err != nil {
+		return "", fmt.Errorf("invalid answer for %q: %w", label, err)
+	}
+	return value, nil
+}
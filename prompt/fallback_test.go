@@ -0,0 +1,184 @@
+package prompt
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestInputFallback(t *testing.T) {
+	t.Setenv("TEST_CLUSTER_NAME", "from-env")
+	t.Setenv("TEST_EMPTY", "")
+
+	config := FromFunc("config key cluster.name", func() (string, bool) { return "from-config", true })
+
+	tests := []struct {
+		name    string
+		opts    []Option
+		want    string
+		wantErr string
+	}{
+		{
+			name: "flag first",
+			opts: []Option{WithFallback(FromFlag("cluster-name", "from-flag"), FromEnv("TEST_CLUSTER_NAME"))},
+			want: "from-flag",
+		},
+		{
+			name: "empty flag skipped",
+			opts: []Option{WithFallback(FromFlag("cluster-name", ""), FromEnv("TEST_CLUSTER_NAME"))},
+			want: "from-env",
+		},
+		{
+			name: "empty env skipped",
+			opts: []Option{WithFallback(FromEnv("TEST_EMPTY"), config)},
+			want: "from-config",
+		},
+		{
+			name: "fallbacks accumulate",
+			opts: []Option{WithFallback(FromFlag("cluster-name", "")), WithFallback(config)},
+			want: "from-config",
+		},
+		{
+			name: "default with DefaultNonInteractive",
+			opts: []Option{WithFallback(FromEnv("TEST_EMPTY")), WithDefault("kubefirst"), WithNonInteractive(DefaultNonInteractive)},
+			want: "kubefirst",
+		},
+		{
+			name:    "default ignored by default",
+			opts:    []Option{WithDefault("kubefirst")},
+			wantErr: `no answer for "Cluster name": input is not a terminal or prompting is disabled`,
+		},
+		{
+			name:    "no source answers",
+			opts:    []Option{WithFallback(FromFlag("cluster-name", ""), FromEnv("TEST_EMPTY"))},
+			wantErr: `no answer for "Cluster name": input is not a terminal or prompting is disabled and none of flag --cluster-name, env TEST_EMPTY is set`,
+		},
+		{
+			name:    "fallback answer validated",
+			opts:    []Option{WithFallback(FromFlag("cluster-name", "x")), WithValidator(MinLen(3))},
+			wantErr: `invalid answer for "Cluster name": must be at least 3 characters`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The reader would answer if it were used.
+			opts := append(tt.opts, WithNoInput(true))
+			got, err := Input(&strings.Builder{}, strings.NewReader("typed\n"), "Cluster name", opts...)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("Input() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Input() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Input() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNoInputError(t *testing.T) {
+	_, err := Password(&strings.Builder{}, pipe(t, "s3cret\n"), "GitHub token",
+		WithFallback(FromFlag("token", ""), FromEnv("TEST_UNSET_TOKEN")))
+
+	var noInput *NoInputError
+	if !errors.As(err, &noInput) {
+		t.Fatalf("Password() error = %v, want a *NoInputError", err)
+	}
+	if noInput.Label != "GitHub token" || len(noInput.Sources) != 2 {
+		t.Errorf("NoInputError = %+v, want the label and both sources", noInput)
+	}
+	if !errors.Is(err, ErrNonInteractive) {
+		t.Errorf("errors.Is(%v, ErrNonInteractive) = false", err)
+	}
+
+	want := `no answer for "GitHub token": input is not a terminal or prompting is disabled and none of flag --token, env TEST_UNSET_TOKEN is set`
+	if err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestNoInputIgnoredOnTerminal(t *testing.T) {
+	got, err := Input(&strings.Builder{}, strings.NewReader("typed\n"), "Cluster name",
+		WithFallback(FromFlag("cluster-name", "from-flag")))
+	if err != nil {
+		t.Fatalf("Input() error = %v", err)
+	}
+	if got != "typed" {
+		t.Errorf("Input() = %q, want the typed answer when the prompt can ask", got)
+	}
+}
+
+func TestConfirmFallback(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    bool
+		wantErr bool
+	}{
+		{value: "yes", want: true},
+		{value: "true", want: true},
+		{value: "N", want: false},
+		{value: "maybe", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := Confirm(&strings.Builder{}, pipe(t, ""), "Delete?", DefaultNo,
+				WithFallback(FromFlag("yes", tt.value)))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Confirm() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Confirm() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMultiSelectFallback(t *testing.T) {
+	items := []string{"argocd", "vault", "atlantis"}
+
+	tests := []struct {
+		name    string
+		value   string
+		opts    []Option
+		want    []string
+		wantErr string
+	}{
+		{name: "list order kept", value: "atlantis, argocd", want: []string{"argocd", "atlantis"}},
+		{name: "replaces preselection", value: "vault", opts: []Option{WithSelected("argocd")}, want: []string{"vault"}},
+		{name: "empty entries skipped", value: "vault,,", want: []string{"vault"}},
+		{name: "unknown item", value: "vault,consul", wantErr: `invalid answer for "Addons": unknown item "consul"`},
+		{name: "above max", value: "vault,argocd", opts: []Option{WithMaxSelected(1)}, wantErr: `invalid answer for "Addons": select at most 1`},
+		{
+			name:    "rejected by validator",
+			value:   "argocd",
+			opts:    []Option{WithSelectionValidator(notAlone)},
+			wantErr: `invalid answer for "Addons": argocd needs vault`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := append(tt.opts, WithFallback(FromFlag("addons", tt.value)))
+			got, err := MultiSelect(&strings.Builder{}, pipe(t, ""), "Addons", items, opts...)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("MultiSelect() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("MultiSelect() error = %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("MultiSelect() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package prompt
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Input asks for a single line of text. It writes the label to w, reads the
+// answer from r and returns it with surrounding whitespace removed.
+//
+// An empty answer yields the value set with WithDefault. If validators are
+// set with WithValidator, the user is asked again until the answer passes
+// or the attempts set with WithMaxAttempts run out.
+//
+// When r is not a terminal or WithNoInput is set, the answer comes from the
+// sources set with WithFallback instead, see WithNonInteractive.
+func Input(w io.Writer, r io.Reader, label string, opts ...Option) (string, error) {
+	o := newOptions(opts)
+
+	if !o.interactive(r) {
+		return o.answer(label)
+	}
+
+	for attempt := 1; ; attempt++ {
+		if _, err := fmt.Fprint(w, formatLabel(label, o)); err != nil {
+			return "", fmt.Errorf("unable to write prompt: %w", err)
+		}
+
+		answer, err := readLine(r)
+		if err != nil {
+			return "", err
+		}
+
+		answer = strings.TrimSpace(answer)
+		if answer == "" {
+			answer = o.defaultValue
+		}
+
+		if err := validate(o.validators, answer); err != nil {
+			fmt.Fprintln(w, errorText(w, err.Error()))
+			if o.tooManyAttempts(attempt) {
+				return "", fmt.Errorf("unable to read %q: %w: %w", label, ErrTooManyAttempts, err)
+			}
+			continue
+		}
+
+		return answer, nil
+	}
+}
+
+// formatLabel renders the label as "Label (e.g. placeholder) [default]: ".
+func formatLabel(label string, o *options) string {
+	var sb strings.Builder
+	sb.WriteString(label)
+	if o.placeholder != "" {
+		fmt.Fprintf(&sb, " (e.g. %s)", o.placeholder)
+	}
+	if o.defaultValue != "" {
+		fmt.Fprintf(&sb, " [%s]", o.defaultValue)
+	}
+	sb.WriteString(": ")
+	return sb.String()
+}
@@ -0,0 +1,95 @@
+package prompt
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestInput(t *testing.T) {
+	tooShort := func(s string) error {
+		if len(s) < 3 {
+			return errors.New("too short")
+		}
+		return nil
+	}
+
+	tests := []struct {
+		name    string
+		in      string
+		opts    []Option
+		want    string
+		wantOut string
+	}{
+		{
+			name:    "answer",
+			in:      "dev\n",
+			want:    "dev",
+			wantOut: "Cluster name: ",
+		},
+		{
+			name:    "whitespace trimmed",
+			in:      "  dev \r\n",
+			want:    "dev",
+			wantOut: "Cluster name: ",
+		},
+		{
+			name:    "default on empty answer",
+			in:      "\n",
+			opts:    []Option{WithDefault("kubefirst")},
+			want:    "kubefirst",
+			wantOut: "Cluster name [kubefirst]: ",
+		},
+		{
+			name:    "default not used for answer",
+			in:      "dev\n",
+			opts:    []Option{WithDefault("kubefirst")},
+			want:    "dev",
+			wantOut: "Cluster name [kubefirst]: ",
+		},
+		{
+			name:    "placeholder is not an answer",
+			in:      "\n",
+			opts:    []Option{WithPlaceholder("my-cluster")},
+			want:    "",
+			wantOut: "Cluster name (e.g. my-cluster): ",
+		},
+		{
+			name:    "placeholder and default",
+			in:      "\n",
+			opts:    []Option{WithPlaceholder("my-cluster"), WithDefault("kubefirst")},
+			want:    "kubefirst",
+			wantOut: "Cluster name (e.g. my-cluster) [kubefirst]: ",
+		},
+		{
+			name:    "re-prompt until valid",
+			in:      "a\nab\nabc\n",
+			opts:    []Option{WithValidator(tooShort)},
+			want:    "abc",
+			wantOut: strings.Repeat("Cluster name:   ✗ too short\n", 2) + "Cluster name: ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out strings.Builder
+			got, err := Input(&out, strings.NewReader(tt.in), "Cluster name", tt.opts...)
+			if err != nil {
+				t.Fatalf("Input() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Input() = %q, want %q", got, tt.want)
+			}
+			if out.String() != tt.wantOut {
+				t.Errorf("output = %q, want %q", out.String(), tt.wantOut)
+			}
+		})
+	}
+}
+
+func TestInputEOF(t *testing.T) {
+	_, err := Input(&strings.Builder{}, strings.NewReader(""), "Cluster name")
+	if err == nil {
+		t.Error("Input() error = nil, want an error at end of input")
+	}
+}
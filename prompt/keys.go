@@ -0,0 +1,97 @@
+package prompt
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"golang.org/x/term"
+)
+
+// ErrInterrupted is returned when the user presses Ctrl+C while a prompt
+// has the terminal in raw mode.
+var ErrInterrupted = errors.New("prompt interrupted")
+
+type key int
+
+const (
+	keyOther key = iota
+	keyUp
+	keyDown
+	keyEnter
+	keySpace
+	keyBackspace
+	keyInterrupt
+	keyChar
+)
+
+// readKey reads one keypress from r, decoding the arrow key escape
+// sequences sent by terminals in raw mode. For keyChar and keyOther the raw
+// byte is returned as well.
+func readKey(r io.Reader) (key, byte, error) {
+	b, err := readByte(r)
+	if err != nil {
+		return keyOther, 0, err
+	}
+
+	switch b {
+	case '\r', '\n':
+		return keyEnter, b, nil
+	case ' ':
+		return keySpace, b, nil
+	case 0x7f, 0x08:
+		return keyBackspace, b, nil
+	case 0x03:
+		return keyInterrupt, b, nil
+	case 0x1b:
+		next, err := readByte(r)
+		if err != nil || next != '[' {
+			return keyOther, b, err
+		}
+		code, err := readByte(r)
+		if err != nil {
+			return keyOther, b, err
+		}
+		switch code {
+		case 'A':
+			return keyUp, code, nil
+		case 'B':
+			return keyDown, code, nil
+		}
+		return keyOther, code, nil
+	}
+
+	if b < 0x20 {
+		return keyOther, b, nil
+	}
+	return keyChar, b, nil
+}
+
+func readByte(r io.Reader) (byte, error) {
+	var buf [1]byte
+	for {
+		n, err := r.Read(buf[:])
+		if n > 0 {
+			return buf[0], nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("unable to read input: %w", err)
+		}
+	}
+}
+
+// makeRaw puts r into raw mode if it is a terminal, so keypresses arrive one
+// at a time without echo. The returned function restores the terminal.
+func makeRaw(r io.Reader) (func(), error) {
+	if !isTerminal(r) {
+		return func() {}, nil
+	}
+
+	fd := int(r.(*os.File).Fd())
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return nil, fmt.Errorf("unable to set terminal to raw mode: %w", err)
+	}
+	return func() { term.Restore(fd, state) }, nil
+}
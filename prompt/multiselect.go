@@ -0,0 +1,242 @@
+package prompt
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// MultiSelect asks the user to pick any number of items from a list and
+// returns the chosen items in list order.
+//
+// The list is navigated with the arrow keys (or j/k), space toggles the
+// item under the cursor, "a" selects or clears all items and enter
+// confirms. WithSelected, WithMinSelected and WithMaxSelected set the
+// initial selection and its bounds; an error is returned if they can't be
+// satisfied or name items that are not in the list. Validators set with
+// WithSelectionValidator check the selection on enter, and the user can
+// change it until it passes or the attempts set with WithMaxAttempts run
+// out.
+//
+// When r is not a terminal or WithNoInput is set, the answer comes from the
+// sources set with WithFallback as a comma-separated list of items. If none
+// has one, MultiSelect returns a *NoInputError, or the preselected items if
+// WithNonInteractive(DefaultNonInteractive) is set and they pass the bounds
+// and validators.
+func MultiSelect(w io.Writer, r io.Reader, label string, items []string, opts ...Option) ([]string, error) {
+	o := newOptions(opts)
+
+	if len(o.validators) > 0 {
+		return nil, fmt.Errorf("unable to select %q: validators are not supported, use WithSelectionValidator", label)
+	}
+	if o.minSelected > len(items) {
+		return nil, fmt.Errorf("unable to select at least %d of %d items", o.minSelected, len(items))
+	}
+	if o.maxSelected > 0 && o.minSelected > o.maxSelected {
+		return nil, fmt.Errorf("unable to select at least %d and at most %d items", o.minSelected, o.maxSelected)
+	}
+
+	m := &multiSelect{
+		w:       w,
+		label:   label,
+		items:   items,
+		checked: make([]bool, len(items)),
+		opts:    o,
+	}
+	for _, s := range o.selected {
+		i := slices.Index(items, s)
+		if i < 0 {
+			return nil, fmt.Errorf("unable to preselect %q: not one of the items", s)
+		}
+		m.checked[i] = true
+	}
+
+	if !o.interactive(r) {
+		if value, ok := o.lookup(); ok {
+			return m.parse(value)
+		}
+		if o.nonInteractive == DefaultNonInteractive && m.validate() == nil {
+			return m.result(), nil
+		}
+		return nil, o.noInputError(label)
+	}
+
+	restore, err := makeRaw(r)
+	if err != nil {
+		return nil, err
+	}
+	defer restore()
+
+	for attempt := 1; ; {
+		m.render()
+
+		k, b, err := readKey(r)
+		if err != nil {
+			return nil, err
+		}
+
+		m.message = ""
+		switch {
+		case k == keyUp || (k == keyChar && b == 'k'):
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case k == keyDown || (k == keyChar && b == 'j'):
+			if m.cursor < len(items)-1 {
+				m.cursor++
+			}
+		case k == keySpace:
+			m.toggle()
+		case k == keyChar && b == 'a':
+			m.toggleAll()
+		case k == keyEnter:
+			err := m.validate()
+			if err == nil {
+				m.finish()
+				return m.result(), nil
+			}
+			if o.tooManyAttempts(attempt) {
+				m.clear()
+				return nil, fmt.Errorf("unable to select %q: %w: %w", label, ErrTooManyAttempts, err)
+			}
+			m.message = err.Error()
+			attempt++
+		case k == keyInterrupt:
+			m.clear()
+			return nil, ErrInterrupted
+		}
+	}
+}
+
+type multiSelect struct {
+	w       io.Writer
+	label   string
+	items   []string
+	checked []bool
+	cursor  int
+	message string
+	lines   int
+	opts    *options
+}
+
+func (m *multiSelect) count() int {
+	n := 0
+	for _, c := range m.checked {
+		if c {
+			n++
+		}
+	}
+	return n
+}
+
+func (m *multiSelect) toggle() {
+	if len(m.items) == 0 {
+		return
+	}
+	if !m.checked[m.cursor] && m.opts.maxSelected > 0 && m.count() >= m.opts.maxSelected {
+		m.message = fmt.Sprintf("select at most %d", m.opts.maxSelected)
+		return
+	}
+	m.checked[m.cursor] = !m.checked[m.cursor]
+}
+
+func (m *multiSelect) toggleAll() {
+	all := m.count() == len(m.items)
+	if !all && m.opts.maxSelected > 0 && len(m.items) > m.opts.maxSelected {
+		m.message = fmt.Sprintf("select at most %d", m.opts.maxSelected)
+		return
+	}
+	for i := range m.checked {
+		m.checked[i] = !all
+	}
+}
+
+// validate returns an error describing why the current selection is not
+// acceptable, or nil if it is.
+func (m *multiSelect) validate() error {
+	n := m.count()
+	if n < m.opts.minSelected {
+		return fmt.Errorf("select at least %d", m.opts.minSelected)
+	}
+	if m.opts.maxSelected > 0 && n > m.opts.maxSelected {
+		return fmt.Errorf("select at most %d", m.opts.maxSelected)
+	}
+	return validateSelection(m.opts.selValidators, m.result())
+}
+
+// parse selects the items in a comma-separated answer from a fallback
+// source, replacing any preselection.
+func (m *multiSelect) parse(value string) ([]string, error) {
+	clear(m.checked)
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		i := slices.Index(m.items, name)
+		if i < 0 {
+			return nil, fmt.Errorf("invalid answer for %q: unknown item %q", m.label, name)
+		}
+		m.checked[i] = true
+	}
+
+	if err := m.validate(); err != nil {
+		return nil, fmt.Errorf("invalid answer for %q: %w", m.label, err)
+	}
+	return m.result(), nil
+}
+
+func (m *multiSelect) result() []string {
+	selected := make([]string, 0, m.count())
+	for i, item := range m.items {
+		if m.checked[i] {
+			selected = append(selected, item)
+		}
+	}
+	return selected
+}
+
+// render redraws the whole list in place. Lines end in "\r\n" because the
+// terminal is in raw mode.
+func (m *multiSelect) render() {
+	var sb strings.Builder
+	if m.lines > 0 {
+		fmt.Fprintf(&sb, "\x1b[%dA", m.lines)
+	}
+
+	fmt.Fprintf(&sb, "\r\x1b[2K%s (space to toggle, a to select all, enter to confirm)\r\n", m.label)
+	for i, item := range m.items {
+		cursor, box := " ", "[ ]"
+		if i == m.cursor {
+			cursor = ">"
+		}
+		if m.checked[i] {
+			box = "[x]"
+		}
+		fmt.Fprintf(&sb, "\r\x1b[2K%s %s %s\r\n", cursor, box, item)
+	}
+	sb.WriteString("\r\x1b[2K")
+	if m.message != "" {
+		sb.WriteString(errorText(m.w, m.message))
+	}
+	sb.WriteString("\r\n")
+
+	m.lines = len(m.items) + 2
+	io.WriteString(m.w, sb.String())
+}
+
+// clear erases the list from the terminal.
+func (m *multiSelect) clear() {
+	if m.lines > 0 {
+		fmt.Fprintf(m.w, "\x1b[%dA\r\x1b[J", m.lines)
+		m.lines = 0
+	}
+}
+
+// finish replaces the list with a single summary line.
+func (m *multiSelect) finish() {
+	m.clear()
+	fmt.Fprintf(m.w, "%s: %s\r\n", m.label, strings.Join(m.result(), ", "))
+}
@@ -0,0 +1,196 @@
+package prompt
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestMultiSelect(t *testing.T) {
+	const (
+		up    = "\x1b[A"
+		down  = "\x1b[B"
+		enter = "\r"
+	)
+	items := []string{"argocd", "vault", "atlantis"}
+
+	tests := []struct {
+		name    string
+		keys    string
+		opts    []Option
+		want    []string
+		wantMsg string
+	}{
+		{name: "nothing", keys: enter, want: []string{}},
+		{name: "toggle first", keys: " " + enter, want: []string{"argocd"}},
+		{name: "toggle twice", keys: "  " + enter, want: []string{}},
+		{name: "arrow keys", keys: down + down + " " + up + " " + enter, want: []string{"vault", "atlantis"}},
+		{name: "j and k", keys: "jj k " + enter, want: []string{"vault", "atlantis"}},
+		{name: "cursor stops at edges", keys: up + "k " + "jjjj " + enter, want: []string{"argocd", "atlantis"}},
+		{name: "newline confirms", keys: " \n", want: []string{"argocd"}},
+		{name: "select all", keys: "a" + enter, want: items},
+		{name: "select all twice clears", keys: "aa" + enter, want: []string{}},
+		{name: "select all after partial", keys: " a" + enter, want: items},
+		{name: "other keys ignored", keys: "x\x1b[C " + enter, want: []string{"argocd"}},
+		{name: "preselected", keys: enter, opts: []Option{WithSelected("vault")}, want: []string{"vault"}},
+		{name: "preselected toggled off", keys: "j " + enter, opts: []Option{WithSelected("vault")}, want: []string{}},
+		{
+			name:    "min enforced",
+			keys:    enter + " " + enter,
+			opts:    []Option{WithMinSelected(1)},
+			want:    []string{"argocd"},
+			wantMsg: "select at least 1",
+		},
+		{
+			name:    "max enforced on toggle",
+			keys:    " j j " + enter,
+			opts:    []Option{WithMaxSelected(2)},
+			want:    []string{"argocd", "vault"},
+			wantMsg: "select at most 2",
+		},
+		{
+			name:    "max enforced on select all",
+			keys:    "a " + enter,
+			opts:    []Option{WithMaxSelected(2)},
+			want:    []string{"argocd"},
+			wantMsg: "select at most 2",
+		},
+		{
+			name:    "selection validator",
+			keys:    " " + enter + "j " + enter,
+			opts:    []Option{WithSelectionValidator(notAlone)},
+			want:    []string{"argocd", "vault"},
+			wantMsg: "argocd needs vault",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out strings.Builder
+			got, err := MultiSelect(&out, strings.NewReader(tt.keys), "Addons", items, tt.opts...)
+			if err != nil {
+				t.Fatalf("MultiSelect() error = %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("MultiSelect() = %q, want %q", got, tt.want)
+			}
+			if tt.wantMsg != "" && !strings.Contains(out.String(), tt.wantMsg) {
+				t.Errorf("output %q doesn't contain %q", out.String(), tt.wantMsg)
+			}
+
+			summary := "Addons: " + strings.Join(tt.want, ", ") + "\r\n"
+			if !strings.HasSuffix(out.String(), summary) {
+				t.Errorf("output %q doesn't end with summary %q", out.String(), summary)
+			}
+		})
+	}
+}
+
+func TestMultiSelectErrors(t *testing.T) {
+	items := []string{"argocd", "vault"}
+
+	tests := []struct {
+		name    string
+		in      string
+		opts    []Option
+		wantErr error
+	}{
+		{
+			name:    "interrupted",
+			in:      " \x03",
+			wantErr: ErrInterrupted,
+		},
+		{
+			name: "end of input",
+			in:   " ",
+		},
+		{
+			name: "min larger than items",
+			in:   "\r",
+			opts: []Option{WithMinSelected(3)},
+		},
+		{
+			name: "min larger than max",
+			in:   "\r",
+			opts: []Option{WithMinSelected(2), WithMaxSelected(1)},
+		},
+		{
+			name: "unknown preselected item",
+			in:   "\r",
+			opts: []Option{WithSelected("argocd", "consul")},
+		},
+		{
+			name:    "too many attempts",
+			in:      " \r\r",
+			opts:    []Option{WithSelectionValidator(notAlone), WithMaxAttempts(2)},
+			wantErr: ErrTooManyAttempts,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MultiSelect(&strings.Builder{}, strings.NewReader(tt.in), "Addons", items, tt.opts...)
+			if err == nil {
+				t.Fatalf("MultiSelect() = %q, want an error", got)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("MultiSelect() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMultiSelectNonInteractive(t *testing.T) {
+	items := []string{"argocd", "vault", "atlantis"}
+
+	tests := []struct {
+		name    string
+		opts    []Option
+		want    []string
+		wantErr bool
+	}{
+		{name: "fails by default", opts: []Option{WithSelected("vault")}, wantErr: true},
+		{
+			name: "preselected",
+			opts: []Option{WithSelected("vault", "atlantis"), WithNonInteractive(DefaultNonInteractive)},
+			want: []string{"vault", "atlantis"},
+		},
+		{
+			name:    "preselected below min",
+			opts:    []Option{WithMinSelected(1), WithNonInteractive(DefaultNonInteractive)},
+			wantErr: true,
+		},
+		{
+			name:    "preselected rejected by validator",
+			opts:    []Option{WithSelected("argocd"), WithSelectionValidator(notAlone), WithNonInteractive(DefaultNonInteractive)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MultiSelect(&strings.Builder{}, pipe(t, ""), "Addons", items, tt.opts...)
+			if tt.wantErr {
+				if !errors.Is(err, ErrNonInteractive) {
+					t.Errorf("MultiSelect() error = %v, want ErrNonInteractive", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("MultiSelect() error = %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("MultiSelect() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// notAlone rejects selections with argocd but not vault.
+func notAlone(items []string) error {
+	if slices.Contains(items, "argocd") && !slices.Contains(items, "vault") {
+		return errors.New("argocd needs vault")
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package prompt
+
+import (
+	"fmt"
+	"io"
+	"unicode/utf8"
+)
+
+// Password asks for a secret such as a token. When r is a terminal, echo is
+// disabled so the value never shows up on screen or in the scrollback;
+// WithMask echoes a mask character per typed character instead. Pasting
+// works as with regular typing.
+//
+// An empty answer yields the value set with WithDefault, which unlike with
+// Input is not shown. Validators set with WithValidator and WithMaxAttempts
+// apply as with Input, and so do WithFallback and WithNoInput, e.g. to read
+// a token from an environment variable in CI.
+func Password(w io.Writer, r io.Reader, label string, opts ...Option) (string, error) {
+	o := newOptions(opts)
+
+	if !o.interactive(r) {
+		return o.answer(label)
+	}
+
+	for attempt := 1; ; attempt++ {
+		if _, err := fmt.Fprintf(w, "%s: ", label); err != nil {
+			return "", fmt.Errorf("unable to write prompt: %w", err)
+		}
+
+		secret, err := readSecret(w, r, o.mask)
+		if err != nil {
+			return "", err
+		}
+		if secret == "" {
+			secret = o.defaultValue
+		}
+
+		if err := validate(o.validators, secret); err != nil {
+			fmt.Fprintln(w, errorText(w, err.Error()))
+			if o.tooManyAttempts(attempt) {
+				return "", fmt.Errorf("unable to read %q: %w: %w", label, ErrTooManyAttempts, err)
+			}
+			continue
+		}
+
+		return secret, nil
+	}
+}
+
+// readSecret reads a line with echo disabled. Only the mask, if any, is
+// written to w.
+func readSecret(w io.Writer, r io.Reader, mask rune) (string, error) {
+	// Readers that aren't terminals are only used for scripted input.
+	if !isTerminal(r) {
+		return readLine(r)
+	}
+
+	restore, err := makeRaw(r)
+	if err != nil {
+		return "", err
+	}
+	defer restore()
+
+	return readMasked(w, r, mask)
+}
+
+// readMasked reads keypresses from a terminal in raw mode until enter,
+// handling backspace and echoing only the mask.
+func readMasked(w io.Writer, r io.Reader, mask rune) (string, error) {
+	var secret []byte
+	for {
+		b, err := readByte(r)
+		if err != nil {
+			return "", err
+		}
+
+		switch b {
+		case '\r', '\n':
+			io.WriteString(w, "\r\n")
+			return string(secret), nil
+		case 0x03:
+			io.WriteString(w, "\r\n")
+			return "", ErrInterrupted
+		case 0x7f, 0x08:
+			if len(secret) == 0 {
+				continue
+			}
+			_, size := utf8.DecodeLastRune(secret)
+			secret = secret[:len(secret)-size]
+			if mask != 0 {
+				io.WriteString(w, "\b \b")
+			}
+		default:
+			if b < 0x20 {
+				continue
+			}
+			secret = append(secret, b)
+			// Echo the mask once per rune, on its first byte.
+			if mask != 0 && !isContinuationByte(b) {
+				io.WriteString(w, string(mask))
+			}
+		}
+	}
+}
+
+func isContinuationByte(b byte) bool {
+	return b&0xc0 == 0x80
+}
@@ -0,0 +1,77 @@
+package prompt
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestPassword(t *testing.T) {
+	var out strings.Builder
+	got, err := Password(&out, strings.NewReader("s3cret\n"), "GitHub token")
+	if err != nil {
+		t.Fatalf("Password() error = %v", err)
+	}
+	if got != "s3cret" {
+		t.Errorf("Password() = %q, want %q", got, "s3cret")
+	}
+	if out.String() != "GitHub token: " {
+		t.Errorf("output = %q, want %q", out.String(), "GitHub token: ")
+	}
+}
+
+func TestPasswordDefault(t *testing.T) {
+	var out strings.Builder
+	got, err := Password(&out, strings.NewReader("\n"), "GitHub token", WithDefault("s3cret"))
+	if err != nil {
+		t.Fatalf("Password() error = %v", err)
+	}
+	if got != "s3cret" {
+		t.Errorf("Password() = %q, want %q", got, "s3cret")
+	}
+	if strings.Contains(out.String(), "s3cret") {
+		t.Errorf("output %q shows the default", out.String())
+	}
+}
+
+func TestReadMasked(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		mask    rune
+		want    string
+		wantOut string
+		wantErr error
+	}{
+		{name: "no echo", in: "abc\r", want: "abc", wantOut: "\r\n"},
+		{name: "masked", in: "abc\r", mask: '*', want: "abc", wantOut: "***\r\n"},
+		{name: "newline ends input", in: "abc\ndef", want: "abc", wantOut: "\r\n"},
+		{name: "backspace", in: "abx\x7fc\r", mask: '*', want: "abc", wantOut: "***\b \b*\r\n"},
+		{name: "ctrl-h backspace", in: "ab\x08\r", want: "a", wantOut: "\r\n"},
+		{name: "backspace on empty", in: "\x7fa\r", mask: '*', want: "a", wantOut: "*\r\n"},
+		{name: "multibyte runes", in: "é€\r", mask: '*', want: "é€", wantOut: "**\r\n"},
+		{name: "backspace removes whole rune", in: "a€\x7f\r", mask: '*', want: "a", wantOut: "**\b \b\r\n"},
+		{name: "pasted input", in: "ghp_0123456789abcdef\r", want: "ghp_0123456789abcdef", wantOut: "\r\n"},
+		{name: "control characters ignored", in: "a\x01\x1bb\r", want: "ab", wantOut: "\r\n"},
+		{name: "interrupted", in: "ab\x03", wantOut: "\r\n", wantErr: ErrInterrupted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out strings.Builder
+			got, err := readMasked(&out, strings.NewReader(tt.in), tt.mask)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("readMasked() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("readMasked() = %q, want %q", got, tt.want)
+			}
+			if out.String() != tt.wantOut {
+				t.Errorf("output = %q, want %q", out.String(), tt.wantOut)
+			}
+			if tt.want != "" && strings.Contains(out.String(), tt.want) {
+				t.Errorf("output %q leaks the secret", out.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+// Package prompt provides small interactive prompts for command line
+// applications, so callers don't have to hand-roll bufio.Scanner loops.
+package prompt
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/konstructio/cli-utils/style"
+	"golang.org/x/term"
+)
+
+// ErrNonInteractive is returned when a prompt needs an answer but can't ask
+// for it, because its input is not a terminal or WithNoInput is set.
+var ErrNonInteractive = errors.New("input is not a terminal or prompting is disabled")
+
+// NonInteractive controls how a prompt behaves when it can't ask, for
+// example when the CLI runs in CI or with stdin redirected, and none of its
+// fallback sources has an answer.
+type NonInteractive int
+
+const (
+	// FailNonInteractive makes the prompt return a *NoInputError.
+	FailNonInteractive NonInteractive = iota
+	// DefaultNonInteractive makes the prompt return its default answer
+	// without asking, or a *NoInputError if it has none.
+	DefaultNonInteractive
+)
+
+// Option configures a prompt.
+type Option func(*options)
+
+type options struct {
+	defaultValue   string
+	placeholder    string
+	validators     []Validator
+	selValidators  []SelectionValidator
+	maxAttempts    int
+	nonInteractive NonInteractive
+	selected       []string
+	minSelected    int
+	maxSelected    int
+	mask           rune
+	fallbacks      []Source
+	noInput        bool
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithDefault sets the value returned when the user submits an empty answer.
+// The default is shown next to the label.
+func WithDefault(value string) Option {
+	return func(o *options) {
+		o.defaultValue = value
+	}
+}
+
+// WithPlaceholder sets an example value displayed next to the label. Unlike
+// a default, the placeholder is never returned as the answer.
+func WithPlaceholder(text string) Option {
+	return func(o *options) {
+		o.placeholder = text
+	}
+}
+
+// WithValidator adds validators that check the answer, in order. When one
+// returns an error, the error is printed below the prompt and the user is
+// asked again. Validators apply to Input and Password; Confirm and
+// MultiSelect return an error when given one, see WithSelectionValidator.
+func WithValidator(validators ...Validator) Option {
+	return func(o *options) {
+		o.validators = append(o.validators, validators...)
+	}
+}
+
+// WithSelectionValidator adds validators that check the items chosen in a
+// MultiSelect, in order, after WithMinSelected and WithMaxSelected. When one
+// returns an error, the error is shown below the list and the user can
+// change the selection.
+func WithSelectionValidator(validators ...SelectionValidator) Option {
+	return func(o *options) {
+		o.selValidators = append(o.selValidators, validators...)
+	}
+}
+
+// WithMaxAttempts limits how many invalid answers are accepted before the
+// prompt gives up with ErrTooManyAttempts. Zero means no limit.
+func WithMaxAttempts(n int) Option {
+	return func(o *options) {
+		o.maxAttempts = n
+	}
+}
+
+// WithFallback adds sources that answer the prompt when it can't ask the
+// user. They are tried in order, e.g. a flag, then an environment variable,
+// then a config file:
+//
+//	prompt.WithFallback(
+//		prompt.FromFlag("cluster-name", clusterName),
+//		prompt.FromEnv("CLUSTER_NAME"),
+//	)
+func WithFallback(sources ...Source) Option {
+	return func(o *options) {
+		o.fallbacks = append(o.fallbacks, sources...)
+	}
+}
+
+// WithNoInput disables asking the user even on a terminal, typically wired
+// to a --no-input flag. The prompt is then answered by its fallbacks.
+func WithNoInput(noInput bool) Option {
+	return func(o *options) {
+		o.noInput = noInput
+	}
+}
+
+// WithNonInteractive sets what the prompt does when it can't ask and none
+// of its fallbacks has an answer. The default is FailNonInteractive.
+func WithNonInteractive(mode NonInteractive) Option {
+	return func(o *options) {
+		o.nonInteractive = mode
+	}
+}
+
+// WithSelected preselects items in a MultiSelect. The preselected items are
+// also the answer used non-interactively with DefaultNonInteractive.
+func WithSelected(items ...string) Option {
+	return func(o *options) {
+		o.selected = items
+	}
+}
+
+// WithMinSelected sets how many items a MultiSelect requires at least.
+func WithMinSelected(n int) Option {
+	return func(o *options) {
+		o.minSelected = n
+	}
+}
+
+// WithMaxSelected sets how many items a MultiSelect allows at most. Zero
+// means no limit.
+func WithMaxSelected(n int) Option {
+	return func(o *options) {
+		o.maxSelected = n
+	}
+}
+
+// WithMask makes Password echo the given character for every typed
+// character, for example '*'. By default nothing is echoed.
+func WithMask(mask rune) Option {
+	return func(o *options) {
+		o.mask = mask
+	}
+}
+
+// isInteractive reports whether r is a terminal. Readers that are not
+// files, such as a strings.Reader in tests, are treated as interactive so
+// scripted answers keep working.
+func isInteractive(r io.Reader) bool {
+	if _, ok := r.(*os.File); !ok {
+		return true
+	}
+	return isTerminal(r)
+}
+
+// isTerminal reports whether r is a file connected to a terminal.
+func isTerminal(r io.Reader) bool {
+	f, ok := r.(*os.File)
+	return ok && term.IsTerminal(int(f.Fd()))
+}
+
+// errorText formats a validation message shown below a prompt, styled as
+// an error when w supports it.
+func errorText(w io.Writer, msg string) string {
+	return "  ✗ " + style.For(w).Error(msg)
+}
+
+// tooManyAttempts reports whether attempt has used up the limit set with
+// WithMaxAttempts.
+func (o *options) tooManyAttempts(attempt int) bool {
+	return o.maxAttempts > 0 && attempt >= o.maxAttempts
+}
+
+// readLine reads a single line from r without buffering past the newline,
+// so consecutive prompts can share the same reader. The trailing "\n" or
+// "\r\n" is stripped. io.EOF is returned only if nothing was read.
+func readLine(r io.Reader) (string, error) {
+	var (
+		sb  strings.Builder
+		buf [1]byte
+	)
+	for {
+		n, err := r.Read(buf[:])
+		if n > 0 {
+			if buf[0] == '\n' {
+				return strings.TrimSuffix(sb.String(), "\r"), nil
+			}
+			sb.WriteByte(buf[0])
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) && sb.Len() > 0 {
+				return strings.TrimSuffix(sb.String(), "\r"), nil
+			}
+			return "", fmt.Errorf("unable to read input: %w", err)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package prompt
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestReadLine(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want []string
+	}{
+		{name: "lf", in: "one\ntwo\n", want: []string{"one", "two"}},
+		{name: "crlf", in: "one\r\ntwo\r\n", want: []string{"one", "two"}},
+		{name: "empty line", in: "\n", want: []string{""}},
+		{name: "eof after text", in: "last", want: []string{"last"}},
+		{name: "eof after cr", in: "last\r", want: []string{"last"}},
+		{name: "eof only", in: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := strings.NewReader(tt.in)
+			for _, want := range tt.want {
+				got, err := readLine(r)
+				if err != nil {
+					t.Fatalf("readLine() error = %v", err)
+				}
+				if got != want {
+					t.Errorf("readLine() = %q, want %q", got, want)
+				}
+			}
+
+			_, err := readLine(r)
+			if !errors.Is(err, io.EOF) {
+				t.Errorf("readLine() at end error = %v, want io.EOF", err)
+			}
+		})
+	}
+}
+
+// TestReadLineDoesNotOverread checks that consecutive prompts can share a
+// reader.
+func TestReadLineDoesNotOverread(t *testing.T) {
+	r := strings.NewReader("first\nsecond\n")
+	if _, err := readLine(r); err != nil {
+		t.Fatalf("readLine() error = %v", err)
+	}
+
+	rest, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	if string(rest) != "second\n" {
+		t.Errorf("remaining input = %q, want %q", rest, "second\n")
+	}
+}
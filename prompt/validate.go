@@ -0,0 +1,81 @@
+package prompt
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrTooManyAttempts is returned when the user keeps giving invalid answers
+// after the number of attempts set with WithMaxAttempts.
+var ErrTooManyAttempts = errors.New("too many invalid attempts")
+
+// Validator checks an answer and returns an error describing the problem if
+// it is not acceptable. The error message is shown to the user as is.
+type Validator func(string) error
+
+// SelectionValidator checks the items chosen in a MultiSelect, in list
+// order, like a Validator checks a typed answer.
+type SelectionValidator func([]string) error
+
+// Required rejects empty answers.
+func Required(s string) error {
+	if strings.TrimSpace(s) == "" {
+		return errors.New("a value is required")
+	}
+	return nil
+}
+
+// MinLen rejects answers shorter than n characters.
+func MinLen(n int) Validator {
+	return func(s string) error {
+		if utf8.RuneCountInString(s) < n {
+			return fmt.Errorf("must be at least %d characters", n)
+		}
+		return nil
+	}
+}
+
+// MaxLen rejects answers longer than n characters.
+func MaxLen(n int) Validator {
+	return func(s string) error {
+		if utf8.RuneCountInString(s) > n {
+			return fmt.Errorf("must be at most %d characters", n)
+		}
+		return nil
+	}
+}
+
+// MatchRegexp rejects answers that don't match re. The message explains the
+// expected format, e.g. "must be lowercase letters, digits or dashes".
+func MatchRegexp(re *regexp.Regexp, message string) Validator {
+	return func(s string) error {
+		if !re.MatchString(s) {
+			return errors.New(message)
+		}
+		return nil
+	}
+}
+
+// validate runs the validators in order and returns the first error.
+func validate(validators []Validator, s string) error {
+	for _, v := range validators {
+		if err := v(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateSelection runs the selection validators in order and returns the
+// first error.
+func validateSelection(validators []SelectionValidator, items []string) error {
+	for _, v := range validators {
+		if err := v(items); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package prompt
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestValidators(t *testing.T) {
+	lowercase := MatchRegexp(regexp.MustCompile(`^[a-z-]+$`), "must be lowercase letters or dashes")
+
+	tests := []struct {
+		name      string
+		validator Validator
+		in        string
+		wantErr   string
+	}{
+		{name: "required", validator: Required, in: "dev"},
+		{name: "required empty", validator: Required, in: "", wantErr: "a value is required"},
+		{name: "required blank", validator: Required, in: "  ", wantErr: "a value is required"},
+		{name: "min len", validator: MinLen(3), in: "dev"},
+		{name: "min len short", validator: MinLen(3), in: "de", wantErr: "must be at least 3 characters"},
+		{name: "min len counts runes", validator: MinLen(3), in: "日本語"},
+		{name: "max len", validator: MaxLen(3), in: "dev"},
+		{name: "max len long", validator: MaxLen(3), in: "devs", wantErr: "must be at most 3 characters"},
+		{name: "regexp", validator: lowercase, in: "my-cluster"},
+		{name: "regexp mismatch", validator: lowercase, in: "My_Cluster", wantErr: "must be lowercase letters or dashes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.validator(tt.in)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("validator(%q) error = %v", tt.in, err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("validator(%q) error = %v, want %q", tt.in, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateOrder(t *testing.T) {
+	err := validate([]Validator{Required, MinLen(3)}, "")
+	if err == nil || err.Error() != "a value is required" {
+		t.Errorf("validate() error = %v, want the first validator's error", err)
+	}
+	if err := validate(nil, ""); err != nil {
+		t.Errorf("validate() without validators error = %v", err)
+	}
+}
+
+func TestWithValidatorAppends(t *testing.T) {
+	var out strings.Builder
+	got, err := Input(&out, strings.NewReader("\nab\nabc\n"), "Name", WithValidator(Required), WithValidator(MinLen(3)))
+	if err != nil {
+		t.Fatalf("Input() error = %v", err)
+	}
+	if got != "abc" {
+		t.Errorf("Input() = %q, want %q", got, "abc")
+	}
+
+	want := "Name:   ✗ a value is required\nName:   ✗ must be at least 3 characters\nName: "
+	if out.String() != want {
+		t.Errorf("output = %q, want %q", out.String(), want)
+	}
+}
+
+func TestMaxAttempts(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(r *strings.Reader, opts ...Option) error
+		in   string
+		rest string
+	}{
+		{
+			name: "input",
+			in:   "\n\n\nlate\n",
+			rest: "late\n",
+			run: func(r *strings.Reader, opts ...Option) error {
+				_, err := Input(&strings.Builder{}, r, "Name", append(opts, WithValidator(Required))...)
+				return err
+			},
+		},
+		{
+			name: "password",
+			in:   "\n\n\nlate\n",
+			rest: "late\n",
+			run: func(r *strings.Reader, opts ...Option) error {
+				_, err := Password(&strings.Builder{}, r, "Token", append(opts, WithValidator(Required))...)
+				return err
+			},
+		},
+		{
+			name: "confirm",
+			in:   "maybe\nperhaps\nunsure\ny\n",
+			rest: "y\n",
+			run: func(r *strings.Reader, opts ...Option) error {
+				_, err := Confirm(&strings.Builder{}, r, "Delete?", DefaultNone, opts...)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := strings.NewReader(tt.in)
+			err := tt.run(r, WithMaxAttempts(3))
+			if !errors.Is(err, ErrTooManyAttempts) {
+				t.Fatalf("error = %v, want ErrTooManyAttempts", err)
+			}
+			if r.Len() != len(tt.rest) {
+				t.Errorf("%d bytes left unread, want %q after 3 attempts", r.Len(), tt.rest)
+			}
+		})
+
+		t.Run(tt.name+" within limit", func(t *testing.T) {
+			if err := tt.run(strings.NewReader(tt.in), WithMaxAttempts(4)); err != nil {
+				t.Errorf("error = %v, want the fourth answer to be accepted", err)
+			}
+		})
+	}
+}
+
+func TestMaxAttemptsKeepsValidationError(t *testing.T) {
+	_, err := Input(&strings.Builder{}, strings.NewReader("ab\n"), "Name", WithValidator(MinLen(3)), WithMaxAttempts(1))
+	if !errors.Is(err, ErrTooManyAttempts) {
+		t.Fatalf("Input() error = %v, want ErrTooManyAttempts", err)
+	}
+	if !strings.Contains(err.Error(), "must be at least 3 characters") {
+		t.Errorf("Input() error = %q, want it to include the validation error", err)
+	}
+}
+
+func TestValidatorRejected(t *testing.T) {
+	failing := func(string) error { return errors.New("never valid") }
+
+	if _, err := Confirm(&strings.Builder{}, strings.NewReader("y\n"), "Delete?", DefaultNo, WithValidator(failing)); err == nil {
+		t.Error("Confirm() error = nil, want an error")
+	}
+	if _, err := MultiSelect(&strings.Builder{}, strings.NewReader(" \r"), "Addons", []string{"a"}, WithValidator(failing)); err == nil {
+		t.Error("MultiSelect() error = nil, want an error")
+	}
+}
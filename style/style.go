@@ -0,0 +1,152 @@
+// Package style provides the text styling shared by the packages in this
+// module, so every Konstruct CLI has the same look.
+//
+// Styling is enabled for an output that is a terminal, unless NO_COLOR is
+// set or TERM is "dumb". On Windows, ANSI processing is turned on for the
+// output's console and styling stays off if that fails. SetEnabled
+// overrides the detection.
+//
+// The package-level functions style text for stdout. Use For to style text
+// written elsewhere, such as stderr.
+package style
+
+import (
+	"io"
+	"os"
+	"sync/atomic"
+
+	"golang.org/x/term"
+)
+
+var (
+	forced   atomic.Bool
+	forcedOn atomic.Bool
+)
+
+// Enabled reports whether styling is applied to stdout.
+func Enabled() bool {
+	return EnabledFor(os.Stdout)
+}
+
+// EnabledFor reports whether styling is applied to output written to w.
+// Writers that are not files, such as buffers, are never styled unless
+// SetEnabled forces it. The environment and w are checked on every call.
+func EnabledFor(w io.Writer) bool {
+	if forced.Load() {
+		return forcedOn.Load()
+	}
+
+	f, ok := w.(*os.File)
+	if !ok || !envAllows() || !term.IsTerminal(int(f.Fd())) {
+		return false
+	}
+	return enableVirtualTerminal(f)
+}
+
+// SetEnabled forces styling on or off for every output, for example from a
+// --color flag.
+func SetEnabled(on bool) {
+	forcedOn.Store(on)
+	forced.Store(true)
+}
+
+// envAllows reports whether the environment permits styling, that is
+// NO_COLOR is unset and TERM is not "dumb".
+func envAllows() bool {
+	return os.Getenv("NO_COLOR") == "" && os.Getenv("TERM") != "dumb"
+}
+
+// Styler styles text for one output. The zero value applies no styling.
+type Styler struct {
+	enabled bool
+}
+
+// For returns a Styler for output written to w, styling text only if
+// EnabledFor(w) is true.
+func For(w io.Writer) Styler {
+	return Styler{enabled: EnabledFor(w)}
+}
+
+// Enabled reports whether s applies styling.
+func (s Styler) Enabled() bool {
+	return s.enabled
+}
+
+func (s Styler) wrap(text, start, end string) string {
+	if !s.enabled {
+		return text
+	}
+	return start + text + end
+}
+
+// Bold renders text in bold.
+func (s Styler) Bold(text string) string {
+	return s.wrap(text, "\x1b[1m", "\x1b[22m")
+}
+
+// Dim renders text faint, for secondary information.
+func (s Styler) Dim(text string) string {
+	return s.wrap(text, "\x1b[2m", "\x1b[22m")
+}
+
+// Success renders text in green.
+func (s Styler) Success(text string) string {
+	return s.wrap(text, "\x1b[32m", "\x1b[39m")
+}
+
+// Warn renders text in yellow.
+func (s Styler) Warn(text string) string {
+	return s.wrap(text, "\x1b[33m", "\x1b[39m")
+}
+
+// Error renders text in red.
+func (s Styler) Error(text string) string {
+	return s.wrap(text, "\x1b[31m", "\x1b[39m")
+}
+
+// Hyperlink renders text as a clickable link to url in terminals that
+// support OSC 8 hyperlinks. Without styling it returns "text (url)", or
+// just url when text is empty or the same.
+func (s Styler) Hyperlink(url, text string) string {
+	if text == "" {
+		text = url
+	}
+	if !s.enabled {
+		if text == url {
+			return url
+		}
+		return text + " (" + url + ")"
+	}
+	return "\x1b]8;;" + url + "\x1b\\" + text + "\x1b]8;;\x1b\\"
+}
+
+// Bold renders s in bold on stdout.
+func Bold(s string) string {
+	return For(os.Stdout).Bold(s)
+}
+
+// Dim renders s faint on stdout, for secondary information.
+func Dim(s string) string {
+	return For(os.Stdout).Dim(s)
+}
+
+// Success renders s in green on stdout.
+func Success(s string) string {
+	return For(os.Stdout).Success(s)
+}
+
+// Warn renders s in yellow on stdout.
+func Warn(s string) string {
+	return For(os.Stdout).Warn(s)
+}
+
+// Error renders s in red on stdout.
+func Error(s string) string {
+	return For(os.Stdout).Error(s)
+}
+
+// Hyperlink renders text as a clickable link to url on stdout, see
+// Styler.Hyperlink.
+func Hyperlink(url, text string) string {
+	return For(os.Stdout).Hyperlink(url, text)
+}
@@ -0,0 +1,97 @@
+package style
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestStyler(t *testing.T) {
+	on := Styler{enabled: true}
+	off := Styler{}
+
+	tests := []struct {
+		name string
+		on   string
+		off  string
+		fn   func(Styler) string
+	}{
+		{name: "bold", fn: func(s Styler) string { return s.Bold("x") }, on: "\x1b[1mx\x1b[22m", off: "x"},
+		{name: "dim", fn: func(s Styler) string { return s.Dim("x") }, on: "\x1b[2mx\x1b[22m", off: "x"},
+		{name: "success", fn: func(s Styler) string { return s.Success("x") }, on: "\x1b[32mx\x1b[39m", off: "x"},
+		{name: "warn", fn: func(s Styler) string { return s.Warn("x") }, on: "\x1b[33mx\x1b[39m", off: "x"},
+		{name: "error", fn: func(s Styler) string { return s.Error("x") }, on: "\x1b[31mx\x1b[39m", off: "x"},
+		{
+			name: "hyperlink",
+			fn:   func(s Styler) string { return s.Hyperlink("https://x.io", "docs") },
+			on:   "\x1b]8;;https://x.io\x1b\\docs\x1b]8;;\x1b\\",
+			off:  "docs (https://x.io)",
+		},
+		{
+			name: "hyperlink without text",
+			fn:   func(s Styler) string { return s.Hyperlink("https://x.io", "") },
+			on:   "\x1b]8;;https://x.io\x1b\\https://x.io\x1b]8;;\x1b\\",
+			off:  "https://x.io",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.fn(on); got != tt.on {
+				t.Errorf("enabled = %q, want %q", got, tt.on)
+			}
+			if got := tt.fn(off); got != tt.off {
+				t.Errorf("disabled = %q, want %q", got, tt.off)
+			}
+		})
+	}
+}
+
+func TestEnabledFor(t *testing.T) {
+	if EnabledFor(&strings.Builder{}) {
+		t.Error("EnabledFor(buffer) = true, want false")
+	}
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe() error = %v", err)
+	}
+	defer r.Close()
+	defer w.Close()
+	if EnabledFor(w) {
+		t.Error("EnabledFor(pipe) = true, want false")
+	}
+}
+
+func TestEnvAllows(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+	t.Setenv("TERM", "xterm-256color")
+	if !envAllows() {
+		t.Error("envAllows() = false, want true")
+	}
+
+	t.Setenv("NO_COLOR", "1")
+	if envAllows() {
+		t.Error("envAllows() = true with NO_COLOR set, want false")
+	}
+
+	t.Setenv("NO_COLOR", "")
+	t.Setenv("TERM", "dumb")
+	if envAllows() {
+		t.Error("envAllows() = true with TERM=dumb, want false")
+	}
+}
+
+func TestSetEnabled(t *testing.T) {
+	t.Cleanup(func() { forced.Store(false) })
+
+	SetEnabled(true)
+	if !EnabledFor(&strings.Builder{}) || !For(&strings.Builder{}).Enabled() {
+		t.Error("EnabledFor() = false after SetEnabled(true)")
+	}
+
+	SetEnabled(false)
+	if got := For(os.Stdout).Error("x"); got != "x" {
+		t.Errorf("Error() = %q after SetEnabled(false), want plain text", got)
+	}
+}
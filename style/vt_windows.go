@@ -0,0 +1,25 @@
+//go:build windows
+
+package style
+
+import (
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// enableVirtualTerminal turns on ANSI escape processing for the console
+// behind f and reports whether it is available. The console mode is only
+// changed the first time, later calls find the flag already set.
+func enableVirtualTerminal(f *os.File) bool {
+	h := windows.Handle(f.Fd())
+
+	var mode uint32
+	if err := windows.GetConsoleMode(h, &mode); err != nil {
+		return false
+	}
+	if mode&windows.ENABLE_VIRTUAL_TERMINAL_PROCESSING != 0 {
+		return true
+	}
+	return windows.SetConsoleMode(h, mode|windows.ENABLE_VIRTUAL_TERMINAL_PROCESSING) == nil
+}
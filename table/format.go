@@ -0,0 +1,204 @@
+package table
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Format selects how a table is rendered, typically from an --output flag.
+type Format string
+
+// Supported formats.
+const (
+	FormatText Format = "text"
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+	FormatCSV  Format = "csv"
+)
+
+// ParseFormat parses an --output value. An empty value and "table" mean
+// FormatText.
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case "", "table":
+		return FormatText, nil
+	case FormatText, FormatJSON, FormatYAML, FormatCSV:
+		return f, nil
+	}
+	return "", fmt.Errorf("unknown output format %q: must be one of text, json, yaml or csv", s)
+}
+
+// RenderFormat writes the table to w in the given format. Text output is
+// the same as Render. The other formats contain the full cell values with
+// escape sequences removed: with headers, each row is an object keyed by
+// header and cells without a header are left out; without headers, each
+// row is a list. JSON and YAML output fails if two headers are the same,
+// since they would produce duplicate keys.
+func (t *Table) RenderFormat(w io.Writer, f Format) error {
+	switch f {
+	case FormatText:
+		return t.Render(w)
+	case FormatJSON:
+		if err := t.checkKeys(); err != nil {
+			return err
+		}
+		return t.renderJSON(w)
+	case FormatYAML:
+		if err := t.checkKeys(); err != nil {
+			return err
+		}
+		return t.renderYAML(w)
+	case FormatCSV:
+		return t.renderCSV(w)
+	}
+	return fmt.Errorf("unknown output format %q", f)
+}
+
+// checkKeys returns an error if two headers are the same once escape
+// sequences are removed, as they can't both be keys of a row object.
+func (t *Table) checkKeys() error {
+	seen := make(map[string]bool, len(t.headers))
+	for _, h := range t.headers {
+		key := stripANSI(h)
+		if seen[key] {
+			return fmt.Errorf("unable to render rows as objects: duplicate header %q", key)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// records returns the rows with escape sequences removed and, when the
+// table has headers, shaped to the header columns.
+func (t *Table) records() [][]string {
+	records := make([][]string, 0, len(t.rows))
+	for _, row := range t.rows {
+		n := len(row)
+		if len(t.headers) > 0 {
+			n = len(t.headers)
+		}
+
+		record := make([]string, n)
+		for i := range record {
+			if i < len(row) {
+				record[i] = stripANSI(row[i])
+			}
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func (t *Table) renderJSON(w io.Writer) error {
+	var sb strings.Builder
+	sb.WriteString("[")
+	for i, record := range t.records() {
+		if i > 0 {
+			sb.WriteString(",")
+		}
+		sb.WriteString("\n  ")
+
+		if len(t.headers) == 0 {
+			sb.WriteString(jsonValue(record))
+			continue
+		}
+
+		// Objects are written by hand to keep the header order.
+		sb.WriteString("{")
+		for j, value := range record {
+			if j > 0 {
+				sb.WriteString(", ")
+			}
+			fmt.Fprintf(&sb, "%s: %s", jsonValue(stripANSI(t.headers[j])), jsonValue(value))
+		}
+		sb.WriteString("}")
+	}
+	if len(t.rows) > 0 {
+		sb.WriteString("\n")
+	}
+	sb.WriteString("]\n")
+
+	if _, err := io.WriteString(w, sb.String()); err != nil {
+		return fmt.Errorf("unable to write table: %w", err)
+	}
+	return nil
+}
+
+// jsonValue encodes v without HTML escaping, so values such as "<none>"
+// stay readable. Strings and string slices always encode.
+func jsonValue(v any) string {
+	var sb strings.Builder
+	enc := json.NewEncoder(&sb)
+	enc.SetEscapeHTML(false)
+	enc.Encode(v)
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+func (t *Table) renderYAML(w io.Writer) error {
+	records := t.records()
+	if len(records) == 0 {
+		if _, err := io.WriteString(w, "[]\n"); err != nil {
+			return fmt.Errorf("unable to write table: %w", err)
+		}
+		return nil
+	}
+
+	var sb strings.Builder
+	for _, record := range records {
+		for j, value := range record {
+			indent := "  "
+			if j == 0 {
+				indent = "- "
+			}
+			if len(t.headers) == 0 {
+				fmt.Fprintf(&sb, "%s- %s\n", indent, yamlScalar(value))
+				continue
+			}
+			fmt.Fprintf(&sb, "%s%s: %s\n", indent, yamlScalar(stripANSI(t.headers[j])), yamlScalar(value))
+		}
+		if len(record) == 0 {
+			sb.WriteString("- []\n")
+		}
+	}
+
+	if _, err := io.WriteString(w, sb.String()); err != nil {
+		return fmt.Errorf("unable to write table: %w", err)
+	}
+	return nil
+}
+
+func (t *Table) renderCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if len(t.headers) > 0 {
+		headers := make([]string, len(t.headers))
+		for i, h := range t.headers {
+			headers[i] = stripANSI(h)
+		}
+		cw.Write(headers)
+	}
+	cw.WriteAll(t.records())
+
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("unable to write table: %w", err)
+	}
+	return nil
+}
+
+var (
+	yamlPlain    = regexp.MustCompile(`^[A-Za-z_/][A-Za-z0-9_./-]*$`)
+	yamlReserved = regexp.MustCompile(`^(?i:true|false|yes|no|on|off|y|n|null)$`)
+)
+
+// yamlScalar writes s unquoted when that is unambiguous and as a
+// double-quoted string otherwise.
+func yamlScalar(s string) string {
+	if yamlPlain.MatchString(s) && !yamlReserved.MatchString(s) {
+		return s
+	}
+	return strconv.Quote(s)
+}
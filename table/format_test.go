@@ -0,0 +1,209 @@
+package table
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Format
+		wantErr bool
+	}{
+		{in: "", want: FormatText},
+		{in: "table", want: FormatText},
+		{in: "text", want: FormatText},
+		{in: "JSON", want: FormatJSON},
+		{in: "yaml", want: FormatYAML},
+		{in: "csv", want: FormatCSV},
+		{in: "xml", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseFormat(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseFormat(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseFormat(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderFormat(t *testing.T) {
+	withHeaders := func() *Table {
+		tbl := New(WithHeaders("NAME", "\x1b[1mSTATUS\x1b[22m"), WithMaxColumnWidth(3))
+		tbl.AddRow("dev", "\x1b[32mready\x1b[0m", "extra")
+		tbl.AddRow("prod")
+		return tbl
+	}
+	withoutHeaders := func() *Table {
+		tbl := New()
+		tbl.AddRow("a", "1")
+		tbl.AddRow()
+		return tbl
+	}
+
+	tests := []struct {
+		name   string
+		table  *Table
+		format Format
+		want   string
+	}{
+		{
+			name:   "json",
+			table:  withHeaders(),
+			format: FormatJSON,
+			want: "[\n" +
+				`  {"NAME": "dev", "STATUS": "ready"},` + "\n" +
+				`  {"NAME": "prod", "STATUS": ""}` + "\n" +
+				"]\n",
+		},
+		{
+			name:   "json without headers",
+			table:  withoutHeaders(),
+			format: FormatJSON,
+			want: "[\n" +
+				`  ["a","1"],` + "\n" +
+				"  []\n" +
+				"]\n",
+		},
+		{
+			name:   "json empty",
+			table:  New(WithHeaders("NAME")),
+			format: FormatJSON,
+			want:   "[]\n",
+		},
+		{
+			name:   "json escaping",
+			table:  tableOf([]string{"K"}, []string{"say \"hi\"\n<b>"}),
+			format: FormatJSON,
+			want:   "[\n" + `  {"K": "say \"hi\"\n<b>"}` + "\n]\n",
+		},
+		{
+			name:   "yaml",
+			table:  withHeaders(),
+			format: FormatYAML,
+			want: "" +
+				"- NAME: dev\n" +
+				"  STATUS: ready\n" +
+				"- NAME: prod\n" +
+				"  STATUS: \"\"\n",
+		},
+		{
+			name:   "yaml without headers",
+			table:  withoutHeaders(),
+			format: FormatYAML,
+			want: "" +
+				"- - a\n" +
+				"  - \"1\"\n" +
+				"- []\n",
+		},
+		{
+			name:   "yaml empty",
+			table:  New(WithHeaders("NAME")),
+			format: FormatYAML,
+			want:   "[]\n",
+		},
+		{
+			name:   "csv",
+			table:  withHeaders(),
+			format: FormatCSV,
+			want: "" +
+				"NAME,STATUS\n" +
+				"dev,ready\n" +
+				"prod,\n",
+		},
+		{
+			name:   "csv escaping",
+			table:  tableOf([]string{"NAME", "NOTE"}, []string{"a,b", "say \"hi\"\nbye"}),
+			format: FormatCSV,
+			want: "" +
+				"NAME,NOTE\n" +
+				"\"a,b\",\"say \"\"hi\"\"\nbye\"\n",
+		},
+		{
+			name:   "text",
+			table:  withHeaders(),
+			format: FormatText,
+			want: "" +
+				"NA…  \x1b[1mST…\x1b[0m\n" +
+				"dev  \x1b[32mre…\x1b[0m  ex…\n" +
+				"pr…\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sb strings.Builder
+			if err := tt.table.RenderFormat(&sb, tt.format); err != nil {
+				t.Fatalf("RenderFormat() error = %v", err)
+			}
+			if got := sb.String(); got != tt.want {
+				t.Errorf("RenderFormat() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("duplicate headers", func(t *testing.T) {
+		tbl := tableOf([]string{"NAME", "\x1b[1mNAME\x1b[22m"}, []string{"a", "b"})
+		for _, f := range []Format{FormatJSON, FormatYAML} {
+			if err := tbl.RenderFormat(&strings.Builder{}, f); err == nil {
+				t.Errorf("RenderFormat(%s) error = nil, want an error", f)
+			}
+		}
+		if err := tbl.RenderFormat(&strings.Builder{}, FormatCSV); err != nil {
+			t.Errorf("RenderFormat(csv) error = %v", err)
+		}
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		if err := New().RenderFormat(&strings.Builder{}, "xml"); err == nil {
+			t.Error("RenderFormat() error = nil, want an error")
+		}
+	})
+}
+
+func TestYAMLScalar(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "dev", want: "dev"},
+		{in: "argo-cd/server_1.2", want: "argo-cd/server_1.2"},
+		{in: "", want: `""`},
+		{in: "true", want: `"true"`},
+		{in: "No", want: `"No"`},
+		{in: "y", want: `"y"`},
+		{in: "off", want: `"off"`},
+		{in: "null", want: `"null"`},
+		{in: "~", want: `"~"`},
+		{in: "42", want: `"42"`},
+		{in: "1.5", want: `"1.5"`},
+		{in: "-1", want: `"-1"`},
+		{in: "a: b", want: `"a: b"`},
+		{in: "# comment", want: `"# comment"`},
+		{in: " padded", want: `" padded"`},
+		{in: "multi\nline", want: `"multi\nline"`},
+		{in: "https://x.io", want: `"https://x.io"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := yamlScalar(tt.in); got != tt.want {
+				t.Errorf("yamlScalar(%q) = %s, want %s", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func tableOf(headers []string, rows ...[]string) *Table {
+	tbl := New(WithHeaders(headers...))
+	for _, row := range rows {
+		tbl.AddRow(row...)
+	}
+	return tbl
+}
@@ -0,0 +1,107 @@
+// Package table renders aligned columns for list commands, with optional
+// headers and truncation of long cells. Widths account for escape
+// sequences and wide characters, so colored cells stay aligned.
+package table
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/konstructio/cli-utils/style"
+)
+
+// Table is a set of rows rendered as aligned columns.
+type Table struct {
+	headers  []string
+	rows     [][]string
+	maxWidth int
+	padding  int
+}
+
+// Option configures a Table.
+type Option func(*Table)
+
+// WithHeaders sets the header row.
+func WithHeaders(headers ...string) Option {
+	return func(t *Table) {
+		t.headers = headers
+	}
+}
+
+// WithMaxColumnWidth truncates cells wider than n columns with an ellipsis.
+// Zero means no limit.
+func WithMaxColumnWidth(n int) Option {
+	return func(t *Table) {
+		t.maxWidth = n
+	}
+}
+
+// WithPadding sets the number of spaces between columns. The default is 2;
+// negative values are treated as 0.
+func WithPadding(n int) Option {
+	return func(t *Table) {
+		t.padding = max(n, 0)
+	}
+}
+
+// New creates an empty table.
+func New(opts ...Option) *Table {
+	t := &Table{padding: 2}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+// AddRow appends a row. Rows may have fewer cells than the table has
+// columns; missing cells are left blank.
+func (t *Table) AddRow(cells ...string) {
+	t.rows = append(t.rows, cells)
+}
+
+// Render writes the table to w. Headers are bold when w supports styling,
+// see style.EnabledFor. Trailing spaces are not written.
+func (t *Table) Render(w io.Writer) error {
+	st := style.For(w)
+
+	rows := t.rows
+	if len(t.headers) > 0 {
+		rows = append([][]string{t.headers}, rows...)
+	}
+
+	var widths []int
+	cells := make([][]string, len(rows))
+	for i, row := range rows {
+		cells[i] = make([]string, len(row))
+		for j, cell := range row {
+			cell = truncate(cell, t.maxWidth)
+			if i == 0 && len(t.headers) > 0 {
+				cell = st.Bold(cell)
+			}
+			cells[i][j] = cell
+			if j == len(widths) {
+				widths = append(widths, 0)
+			}
+			widths[j] = max(widths[j], width(cell))
+		}
+	}
+
+	gap := strings.Repeat(" ", t.padding)
+	for _, row := range cells {
+		var sb strings.Builder
+		for j, cell := range row {
+			if j > 0 {
+				sb.WriteString(gap)
+			}
+			sb.WriteString(cell)
+			if j < len(row)-1 {
+				sb.WriteString(strings.Repeat(" ", widths[j]-width(cell)))
+			}
+		}
+		if _, err := fmt.Fprintln(w, strings.TrimRight(sb.String(), " ")); err != nil {
+			return fmt.Errorf("unable to write table: %w", err)
+		}
+	}
+	return nil
+}
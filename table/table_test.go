@@ -0,0 +1,117 @@
+package table
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+		rows [][]string
+		want string
+	}{
+		{
+			name: "empty",
+			want: "",
+		},
+		{
+			name: "headers only",
+			opts: []Option{WithHeaders("NAME", "STATUS")},
+			want: "NAME  STATUS\n",
+		},
+		{
+			name: "aligned",
+			opts: []Option{WithHeaders("NAME", "STATUS")},
+			rows: [][]string{{"dev", "ready"}, {"production", "failed"}},
+			want: "" +
+				"NAME        STATUS\n" +
+				"dev         ready\n" +
+				"production  failed\n",
+		},
+		{
+			name: "without headers",
+			rows: [][]string{{"a", "1"}, {"bb", "2"}},
+			want: "" +
+				"a   1\n" +
+				"bb  2\n",
+		},
+		{
+			name: "colored cells",
+			opts: []Option{WithHeaders("STATUS", "NAME")},
+			rows: [][]string{{"\x1b[32mready\x1b[0m", "dev"}, {"\x1b[31mfailed\x1b[0m", "prod"}},
+			want: "" +
+				"STATUS  NAME\n" +
+				"\x1b[32mready\x1b[0m   dev\n" +
+				"\x1b[31mfailed\x1b[0m  prod\n",
+		},
+		{
+			name: "wide cells",
+			opts: []Option{WithHeaders("STATUS", "NAME")},
+			rows: [][]string{{"✅", "dev"}, {"⚠️ slow", "stage"}, {"日本", "prod"}},
+			want: "" +
+				"STATUS   NAME\n" +
+				"✅       dev\n" +
+				"⚠️ slow  stage\n" +
+				"日本     prod\n",
+		},
+		{
+			name: "short rows",
+			opts: []Option{WithHeaders("NAME", "STATUS", "URL")},
+			rows: [][]string{{"dev"}, {"prod", "ready", "https://x.io"}},
+			want: "" +
+				"NAME  STATUS  URL\n" +
+				"dev\n" +
+				"prod  ready   https://x.io\n",
+		},
+		{
+			name: "long rows",
+			opts: []Option{WithHeaders("NAME")},
+			rows: [][]string{{"dev", "extra"}, {"production"}},
+			want: "" +
+				"NAME\n" +
+				"dev         extra\n" +
+				"production\n",
+		},
+		{
+			name: "max column width",
+			opts: []Option{WithHeaders("NAME", "URL"), WithMaxColumnWidth(8)},
+			rows: [][]string{{"dev", "https://example.com"}},
+			want: "" +
+				"NAME  URL\n" +
+				"dev   https:/…\n",
+		},
+		{
+			name: "padding",
+			opts: []Option{WithPadding(1)},
+			rows: [][]string{{"a", "b"}},
+			want: "a b\n",
+		},
+		{
+			name: "negative padding",
+			opts: []Option{WithPadding(-1)},
+			rows: [][]string{{"a", "b"}, {"cc", "d"}},
+			want: "" +
+				"a b\n" +
+				"ccd\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := New(tt.opts...)
+			for _, row := range tt.rows {
+				tbl.AddRow(row...)
+			}
+
+			var sb strings.Builder
+			if err := tbl.Render(&sb); err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if got := sb.String(); got != tt.want {
+				t.Errorf("Render() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
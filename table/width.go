@@ -0,0 +1,167 @@
+package table
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// escapeLen returns the length of the escape sequence at the start of s,
+// or 0 if s doesn't start with one. It recognizes CSI sequences such as
+// colors ("\x1b[31m") and OSC sequences such as hyperlinks
+// ("\x1b]8;;url\x1b\\").
+func escapeLen(s string) int {
+	if len(s) < 2 || s[0] != 0x1b {
+		return 0
+	}
+
+	switch s[1] {
+	case '[':
+		i := 2
+		for i < len(s) && s[i] >= 0x30 && s[i] <= 0x3f {
+			i++
+		}
+		for i < len(s) && s[i] >= 0x20 && s[i] <= 0x2f {
+			i++
+		}
+		if i < len(s) && s[i] >= 0x40 && s[i] <= 0x7e {
+			return i + 1
+		}
+	case ']':
+		for i := 2; i < len(s); i++ {
+			switch {
+			case s[i] == 0x07:
+				return i + 1
+			case s[i] == 0x1b:
+				if i+1 < len(s) && s[i+1] == '\\' {
+					return i + 2
+				}
+				return 0
+			}
+		}
+	}
+	return 0
+}
+
+// stripANSI removes escape sequences from s.
+func stripANSI(s string) string {
+	if !strings.Contains(s, "\x1b") {
+		return s
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(s); {
+		if n := escapeLen(s[i:]); n > 0 {
+			i += n
+			continue
+		}
+		sb.WriteByte(s[i])
+		i++
+	}
+	return sb.String()
+}
+
+// width returns the number of terminal columns s occupies, ignoring escape
+// sequences and counting wide characters such as CJK and emoji as two.
+func width(s string) int {
+	s = stripANSI(s)
+	n := 0
+	for i := 0; i < len(s); {
+		size, w := nextCluster(s[i:])
+		n += w
+		i += size
+	}
+	return n
+}
+
+// nextCluster decodes the rune at the start of s together with the
+// zero-width runes following it, such as combining marks or a variation
+// selector, and returns their length in bytes and display width. A rune
+// followed by U+FE0F is rendered as an emoji, which is two columns wide
+// even when the rune alone is narrow, like "⚠️".
+func nextCluster(s string) (size, w int) {
+	r, size := utf8.DecodeRuneInString(s)
+	w = runewidth.RuneWidth(r)
+	for size < len(s) {
+		next, n := utf8.DecodeRuneInString(s[size:])
+		switch {
+		case next < 0x20:
+			// Control characters, including the start of an escape
+			// sequence, are never part of the cluster.
+			return size, w
+		case next == '\ufe0f':
+			w = max(w, 2)
+		case next == '\ufe0e' || runewidth.RuneWidth(next) == 0:
+			// Combining marks and the text presentation selector add
+			// no width.
+		default:
+			return size, w
+		}
+		size += n
+	}
+	return size, w
+}
+
+// truncate shortens s to at most n columns, ending it with "…". Escape
+// sequences before the cut are kept. If any styling was cut short a reset
+// is appended, and a hyperlink left open is closed, so neither leaks into
+// the rest of the line.
+func truncate(s string, n int) string {
+	if n <= 0 || width(s) <= n {
+		return s
+	}
+
+	var (
+		sb       strings.Builder
+		used     int
+		styled   bool
+		linkOpen bool
+	)
+	for i := 0; i < len(s); {
+		if size := escapeLen(s[i:]); size > 0 {
+			seq := s[i : i+size]
+			sb.WriteString(seq)
+			i += size
+
+			switch {
+			case strings.HasPrefix(seq, "\x1b]8;"):
+				linkOpen = hyperlinkURI(seq) != ""
+			case seq[1] == '[':
+				styled = true
+			}
+			continue
+		}
+
+		size, w := nextCluster(s[i:])
+		if used+w > n-1 {
+			break
+		}
+		sb.WriteString(s[i : i+size])
+		used += w
+		i += size
+	}
+
+	sb.WriteString("…")
+	if styled {
+		sb.WriteString("\x1b[0m")
+	}
+	if linkOpen {
+		sb.WriteString("\x1b]8;;\x1b\\")
+	}
+	return sb.String()
+}
+
+// hyperlinkURI returns the URI of an OSC 8 sequence, "\x1b]8;params;uri"
+// followed by a terminator. An empty URI closes the current link.
+func hyperlinkURI(seq string) string {
+	body := strings.TrimPrefix(seq, "\x1b]8;")
+	if strings.HasSuffix(body, "\x07") {
+		body = body[:len(body)-1]
+	} else {
+		body = body[:len(body)-2]
+	}
+
+	_, uri, _ := strings.Cut(body, ";")
+	return uri
+}
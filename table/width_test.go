@@ -0,0 +1,102 @@
+package table
+
+import "testing"
+
+func TestWidth(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want int
+	}{
+		{name: "ascii", in: "ready", want: 5},
+		{name: "empty", in: "", want: 0},
+		{name: "colored", in: "\x1b[32mready\x1b[0m", want: 5},
+		{name: "bold red", in: "\x1b[1;31mfailed\x1b[22;39m", want: 6},
+		{name: "hyperlink", in: "\x1b]8;;https://x.io\x1b\\docs\x1b]8;;\x1b\\", want: 4},
+		{name: "hyperlink with BEL", in: "\x1b]8;;https://x.io\x07docs\x1b]8;;\x07", want: 4},
+		{name: "cjk", in: "日本語", want: 6},
+		{name: "combining mark", in: "é", want: 1},
+		{name: "check mark emoji", in: "✅", want: 2},
+		{name: "hourglass emoji", in: "⏳", want: 2},
+		{name: "rocket emoji", in: "🚀", want: 2},
+		{name: "emoji presentation selector", in: "⚠️", want: 2},
+		{name: "text presentation selector", in: "⚠︎", want: 1},
+		{name: "emoji and text", in: "✅ done", want: 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := width(tt.in); got != tt.want {
+				t.Errorf("width(%q) = %d, want %d", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStripANSI(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "plain", in: "plain", want: "plain"},
+		{name: "sgr", in: "\x1b[31mred\x1b[0m", want: "red"},
+		{name: "hyperlink", in: "\x1b]8;;https://x.io\x1b\\docs\x1b]8;;\x1b\\", want: "docs"},
+		{name: "unterminated escape kept", in: "a\x1b[", want: "a\x1b["},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stripANSI(tt.in); got != tt.want {
+				t.Errorf("stripANSI(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		n    int
+		want string
+	}{
+		{name: "fits", in: "short", n: 5, want: "short"},
+		{name: "no limit", in: "long value", n: 0, want: "long value"},
+		{name: "plain", in: "long value", n: 5, want: "long…"},
+		{name: "wide runes", in: "日本語クラスタ", n: 6, want: "日本…"},
+		{name: "wide rune not split", in: "a日本", n: 3, want: "a…"},
+		{name: "emoji with selector", in: "⚠️⚠️⚠️", n: 4, want: "⚠️…"},
+		{name: "sgr reset appended", in: "\x1b[31mfailed badly\x1b[0m", n: 7, want: "\x1b[31mfailed…\x1b[0m"},
+		{
+			name: "hyperlink closed",
+			in:   "\x1b]8;;https://x.io\x1b\\hello world\x1b]8;;\x1b\\",
+			n:    6,
+			want: "\x1b]8;;https://x.io\x1b\\hello…\x1b]8;;\x1b\\",
+		},
+		{
+			name: "hyperlink already closed",
+			in:   "\x1b]8;;https://x.io\x1b\\hi\x1b]8;;\x1b\\ and more",
+			n:    7,
+			want: "\x1b]8;;https://x.io\x1b\\hi\x1b]8;;\x1b\\ and…",
+		},
+		{
+			name: "styled hyperlink",
+			in:   "\x1b[1m\x1b]8;;https://x.io\x07hello world\x1b]8;;\x07\x1b[22m",
+			n:    3,
+			want: "\x1b[1m\x1b]8;;https://x.io\x07he…\x1b[0m\x1b]8;;\x1b\\",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := truncate(tt.in, tt.n)
+			if got != tt.want {
+				t.Errorf("truncate(%q, %d) = %q, want %q", tt.in, tt.n, got, tt.want)
+			}
+			if tt.n > 0 && width(got) > tt.n {
+				t.Errorf("truncate(%q, %d) is %d columns wide", tt.in, tt.n, width(got))
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+// Package tree renders hierarchies such as cluster → namespaces →
+// applications with box-drawing connectors and per-node status glyphs.
+package tree
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/konstructio/cli-utils/style"
+)
+
+// Status is the outcome shown in front of a node's label.
+type Status int
+
+// Node statuses. StatusNone shows no glyph.
+const (
+	StatusNone Status = iota
+	StatusSuccess
+	StatusFailure
+	StatusWarning
+	StatusPending
+	StatusSkipped
+)
+
+// Glyph returns the symbol displayed for the status on stdout: an emoji
+// when stdout supports styling, or an ASCII marker such as "[ok]" on dumb
+// terminals, legacy consoles and pipes.
+func (s Status) Glyph() string {
+	return s.glyph(style.For(os.Stdout))
+}
+
+func (s Status) glyph(st style.Styler) string {
+	if st.Enabled() {
+		switch s {
+		case StatusSuccess:
+			return "✅"
+		case StatusFailure:
+			return "🔴"
+		case StatusWarning:
+			return "⚠️"
+		case StatusPending:
+			return "⏳"
+		case StatusSkipped:
+			return "➖"
+		}
+		return ""
+	}
+
+	switch s {
+	case StatusSuccess:
+		return "[ok]"
+	case StatusFailure:
+		return "[fail]"
+	case StatusWarning:
+		return "[warn]"
+	case StatusPending:
+		return "[wait]"
+	case StatusSkipped:
+		return "[skip]"
+	}
+	return ""
+}
+
+// label colors a node's label to match its status.
+func (s Status) label(st style.Styler, text string) string {
+	switch s {
+	case StatusSuccess:
+		return st.Success(text)
+	case StatusFailure:
+		return st.Error(text)
+	case StatusWarning:
+		return st.Warn(text)
+	case StatusPending, StatusSkipped:
+		return st.Dim(text)
+	}
+	return text
+}
+
+// Node is an element of the tree. The zero value is an empty root.
+type Node struct {
+	Label    string
+	Status   Status
+	Children []*Node
+}
+
+// New creates a root node.
+func New(label string) *Node {
+	return &Node{Label: label}
+}
+
+// Add appends a child with the given label and returns it, so deeper levels
+// can be built from the result.
+func (n *Node) Add(label string) *Node {
+	child := New(label)
+	n.Children = append(n.Children, child)
+	return child
+}
+
+// WithStatus sets the node's status and returns the node, for chaining
+// after Add.
+func (n *Node) WithStatus(s Status) *Node {
+	n.Status = s
+	return n
+}
+
+// Render writes the tree to w, one node per line. When w supports
+// styling, statuses are shown as emoji and labels are colored; otherwise
+// ASCII markers are used. Labels spanning several lines stay aligned under
+// their node.
+func (n *Node) Render(w io.Writer) error {
+	if _, err := io.WriteString(w, n.render(style.For(w))); err != nil {
+		return fmt.Errorf("unable to write tree: %w", err)
+	}
+	return nil
+}
+
+// String returns the tree rendered for stdout.
+func (n *Node) String() string {
+	return n.render(style.For(os.Stdout))
+}
+
+func (n *Node) render(st style.Styler) string {
+	var sb strings.Builder
+	n.write(&sb, st, "", "")
+	return sb.String()
+}
+
+// write renders n with first in front of its first line and rest in front
+// of everything below it.
+func (n *Node) write(sb *strings.Builder, st style.Styler, first, rest string) {
+	glyph := n.Status.glyph(st)
+
+	lines := strings.Split(n.Label, "\n")
+
+	// Continuation lines of the label line up with its text, after the
+	// glyph, keeping the bar down to the children's connectors. Without a
+	// glyph there is no room for the bar, so a multi-line label with
+	// children is moved right by a two-column gutter instead.
+	lead, indent := "", 0
+	switch {
+	case glyph != "":
+		lead, indent = glyph+" ", glyphWidth(glyph)+1
+	case len(lines) > 1 && len(n.Children) > 0:
+		lead, indent = "  ", 2
+	}
+	cont := rest
+	if len(n.Children) > 0 {
+		cont += "│" + strings.Repeat(" ", max(indent-1, 0))
+	} else {
+		cont += strings.Repeat(" ", indent)
+	}
+	for i, line := range lines {
+		if i == 0 {
+			sb.WriteString(first)
+			sb.WriteString(lead)
+		} else {
+			sb.WriteString(cont)
+		}
+		sb.WriteString(n.Status.label(st, line))
+		sb.WriteString("\n")
+	}
+
+	for i, child := range n.Children {
+		if i == len(n.Children)-1 {
+			child.write(sb, st, rest+"└── ", rest+"    ")
+		} else {
+			child.write(sb, st, rest+"├── ", rest+"│   ")
+		}
+	}
+}
+
+// glyphWidth returns the number of columns a glyph occupies. ASCII markers
+// take one column per byte and every emoji glyph takes two.
+func glyphWidth(glyph string) int {
+	if glyph == "" {
+		return 0
+	}
+	if glyph[0] < utf8.RuneSelf {
+		return len(glyph)
+	}
+	return 2
+}
@@ -0,0 +1,167 @@
+package tree
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/konstructio/cli-utils/style"
+)
+
+func sample() *Node {
+	root := New("cluster dev").WithStatus(StatusSuccess)
+	argocd := root.Add("namespace argocd").WithStatus(StatusWarning)
+	argocd.Add("argocd-server").WithStatus(StatusPending)
+	argocd.Add("argocd-repo-server")
+	vault := root.Add("namespace vault").WithStatus(StatusFailure)
+	vault.Add("vault-0").WithStatus(StatusSkipped)
+	return root
+}
+
+func TestRender(t *testing.T) {
+	want := "" +
+		"[ok] cluster dev\n" +
+		"├── [warn] namespace argocd\n" +
+		"│   ├── [wait] argocd-server\n" +
+		"│   └── argocd-repo-server\n" +
+		"└── [fail] namespace vault\n" +
+		"    └── [skip] vault-0\n"
+
+	var sb strings.Builder
+	if err := sample().Render(&sb); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if sb.String() != want {
+		t.Errorf("Render() =\n%s\nwant\n%s", sb.String(), want)
+	}
+}
+
+func TestRenderStyled(t *testing.T) {
+	style.SetEnabled(true)
+	t.Cleanup(func() { style.SetEnabled(false) })
+
+	want := "" +
+		"✅ \x1b[32mcluster dev\x1b[39m\n" +
+		"├── ⚠️ \x1b[33mnamespace argocd\x1b[39m\n" +
+		"│   ├── ⏳ \x1b[2margocd-server\x1b[22m\n" +
+		"│   └── argocd-repo-server\n" +
+		"└── 🔴 \x1b[31mnamespace vault\x1b[39m\n" +
+		"    └── ➖ \x1b[2mvault-0\x1b[22m\n"
+
+	var sb strings.Builder
+	if err := sample().Render(&sb); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if sb.String() != want {
+		t.Errorf("Render() =\n%s\nwant\n%s", sb.String(), want)
+	}
+}
+
+func TestRenderMultiLineRootWithoutStatus(t *testing.T) {
+	root := New("root\nsecond")
+	root.Add("child\nmore")
+
+	want := "" +
+		"  root\n" +
+		"│ second\n" +
+		"└── child\n" +
+		"    more\n"
+
+	var sb strings.Builder
+	if err := root.Render(&sb); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if sb.String() != want {
+		t.Errorf("Render() =\n%s\nwant\n%s", sb.String(), want)
+	}
+}
+
+func TestRenderSingleNode(t *testing.T) {
+	var sb strings.Builder
+	if err := New("alone").Render(&sb); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if sb.String() != "alone\n" {
+		t.Errorf("Render() = %q, want %q", sb.String(), "alone\n")
+	}
+}
+
+func TestRenderMultiLineLabels(t *testing.T) {
+	root := New("cluster dev\nregion us-east-1").WithStatus(StatusSuccess)
+	ns := root.Add("ns-a\nsecond line").WithStatus(StatusSuccess)
+	ns.Add("app\nimage v2.9").WithStatus(StatusFailure)
+	root.Add("ns-b\nno status")
+	bare := root.Add("ns-c\nno status, children")
+	bare.Add("child\nmore")
+
+	tests := []struct {
+		name   string
+		styled bool
+		want   string
+	}{
+		{
+			name: "ascii",
+			want: "" +
+				"[ok] cluster dev\n" +
+				"│    region us-east-1\n" +
+				"├── [ok] ns-a\n" +
+				"│   │    second line\n" +
+				"│   └── [fail] app\n" +
+				"│              image v2.9\n" +
+				"├── ns-b\n" +
+				"│   no status\n" +
+				"└──   ns-c\n" +
+				"    │ no status, children\n" +
+				"    └── child\n" +
+				"        more\n",
+		},
+		{
+			name:   "emoji",
+			styled: true,
+			want: "" +
+				"✅ cluster dev\n" +
+				"│  region us-east-1\n" +
+				"├── ✅ ns-a\n" +
+				"│   │  second line\n" +
+				"│   └── 🔴 app\n" +
+				"│          image v2.9\n" +
+				"├── ns-b\n" +
+				"│   no status\n" +
+				"└──   ns-c\n" +
+				"    │ no status, children\n" +
+				"    └── child\n" +
+				"        more\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			style.SetEnabled(tt.styled)
+			t.Cleanup(func() { style.SetEnabled(false) })
+
+			var sb strings.Builder
+			if err := root.Render(&sb); err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			// Colors don't affect alignment; compare the plain text.
+			got := stripSGR(sb.String())
+			if got != tt.want {
+				t.Errorf("Render() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+// stripSGR removes color sequences from s.
+func stripSGR(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == 0x1b {
+			for i < len(s) && s[i] != 'm' {
+				i++
+			}
+			continue
+		}
+		sb.WriteByte(s[i])
+	}
+	return sb.String()
+}